@@ -0,0 +1,74 @@
+// Package cctx wraps a Go channel so every send and receive takes a
+// context.Context, turning what would otherwise be an indefinite block (and,
+// in the tutorial's unbuffered() example, a deadlock)
+// into a recoverable ctx.Err().
+package cctx
+
+import (
+	"context"
+	"time"
+)
+
+// Chan wraps a channel of T so Send and Recv can be cancelled or time out
+// via a context.Context instead of blocking forever.
+type Chan[T any] struct {
+	ch          chan T
+	sendTimeout time.Duration
+}
+
+// Option configures a Chan created by New.
+type Option[T any] func(*Chan[T])
+
+// WithSendTimeout bounds how long Send will wait, independently of whatever
+// deadline the caller's context carries. Use it to give a producer a
+// self-imposed ceiling on top of per-call cancellation.
+func WithSendTimeout[T any](d time.Duration) Option[T] {
+	return func(c *Chan[T]) { c.sendTimeout = d }
+}
+
+// New creates a Chan with the given buffer size, following make(chan T, size) semantics.
+func New[T any](size int, opts ...Option[T]) *Chan[T] {
+	c := &Chan[T]{ch: make(chan T, size)}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Send delivers v on the underlying channel. It returns ctx.Err() if ctx is
+// done first, or context.DeadlineExceeded if the Chan's own send timeout
+// (see WithSendTimeout) elapses first.
+func (c *Chan[T]) Send(ctx context.Context, v T) error {
+	var deadline <-chan time.Time
+	if c.sendTimeout > 0 {
+		timer := time.NewTimer(c.sendTimeout)
+		defer timer.Stop()
+		deadline = timer.C
+	}
+
+	select {
+	case c.ch <- v:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-deadline:
+		return context.DeadlineExceeded
+	}
+}
+
+// Recv receives a value from the underlying channel, or returns ctx.Err()
+// (and the zero value of T) if ctx is done first.
+func (c *Chan[T]) Recv(ctx context.Context) (T, error) {
+	select {
+	case v := <-c.ch:
+		return v, nil
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+// Close closes the underlying channel.
+func (c *Chan[T]) Close() {
+	close(c.ch)
+}
@@ -0,0 +1,51 @@
+package cctx_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Fvieira99/unbuferred-buffered-channels/pkg/cctx"
+)
+
+// ExampleChan_unbuffered retrofits the tutorial's unbuffered() deadlock: a
+// send with no ready receiver now returns context.DeadlineExceeded instead
+// of blocking forever.
+func ExampleChan_unbuffered() {
+	c := cctx.New[int](0)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := c.Send(ctx, 10)
+	fmt.Println("recovered:", errors.Is(err, context.DeadlineExceeded))
+	// Output:
+	// recovered: true
+}
+
+// ExampleChan_bufferedCorrect retrofits bufferedCorrect(): a ready consumer
+// receives the value before the context expires, so Send succeeds instead
+// of erroring out.
+func ExampleChan_bufferedCorrect() {
+	c := cctx.New[int](10)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		msg, err := c.Recv(context.Background())
+		if err == nil {
+			fmt.Println("Message from channel: ", msg)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	if err := c.Send(ctx, 10); err != nil {
+		fmt.Println("unexpected error:", err)
+	}
+	<-done
+	// Output:
+	// Message from channel:  10
+}
@@ -0,0 +1,54 @@
+// Package chanx provides small, composable helpers for working with Go
+// channels without risking an accidental deadlock: non-blocking
+// send/receive attempts and timeout-bound variants.
+package chanx
+
+import "time"
+
+// TrySend attempts to send v on ch without blocking, reporting whether it
+// was delivered. Use it when ch may have no ready receiver or spare buffer
+// capacity and a blocking send is not acceptable.
+func TrySend[T any](ch chan<- T, v T) bool {
+	select {
+	case ch <- v:
+		return true
+	default:
+		return false
+	}
+}
+
+// TryRecv attempts to receive a value from ch without blocking. The second
+// return value reports whether a value was received.
+func TryRecv[T any](ch <-chan T) (T, bool) {
+	select {
+	case v := <-ch:
+		return v, true
+	default:
+		var zero T
+		return zero, false
+	}
+}
+
+// SendWithTimeout sends v on ch, giving up after timeout has elapsed instead
+// of blocking forever. It reports whether the value was delivered.
+func SendWithTimeout[T any](ch chan<- T, v T, timeout time.Duration) bool {
+	select {
+	case ch <- v:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+// RecvWithTimeout receives a value from ch, giving up after timeout has
+// elapsed instead of blocking forever. The second return value reports
+// whether a value was received.
+func RecvWithTimeout[T any](ch <-chan T, timeout time.Duration) (T, bool) {
+	select {
+	case v := <-ch:
+		return v, true
+	case <-time.After(timeout):
+		var zero T
+		return zero, false
+	}
+}
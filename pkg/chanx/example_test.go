@@ -0,0 +1,67 @@
+package chanx_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Fvieira99/unbuferred-buffered-channels/pkg/chanx"
+)
+
+// ExampleTrySend shows that a send attempt on an unbuffered channel with no
+// ready receiver never blocks: it just reports false instead of deadlocking,
+// the way the tutorial's unbuffered() function used to.
+func ExampleTrySend() {
+	c := make(chan int)
+
+	ok := chanx.TrySend(c, 10)
+	fmt.Println("delivered:", ok)
+	// Output:
+	// delivered: false
+}
+
+// ExampleTrySend_delivered shows a successful non-blocking send: with spare
+// buffer capacity there's nothing to wait on, so the send succeeds on the
+// first attempt.
+func ExampleTrySend_delivered() {
+	c := make(chan int, 1)
+
+	ok := chanx.TrySend(c, 10)
+	fmt.Println("delivered:", ok)
+	fmt.Println("Message from channel: ", <-c)
+	// Output:
+	// delivered: true
+	// Message from channel:  10
+}
+
+// ExampleTryRecv shows that receiving from an empty channel never blocks.
+func ExampleTryRecv() {
+	c := make(chan int)
+
+	_, ok := chanx.TryRecv(c)
+	fmt.Println("received:", ok)
+	// Output:
+	// received: false
+}
+
+// ExampleSendWithTimeout turns the tutorial's unbuffered() deadlock into an
+// observable failure: the send gives up after the deadline instead of
+// blocking forever.
+func ExampleSendWithTimeout() {
+	c := make(chan int)
+
+	ok := chanx.SendWithTimeout(c, 10, 10*time.Millisecond)
+	fmt.Println("delivered:", ok)
+	// Output:
+	// delivered: false
+}
+
+// ExampleRecvWithTimeout shows a receive giving up after the deadline
+// instead of blocking forever.
+func ExampleRecvWithTimeout() {
+	c := make(chan int)
+
+	_, ok := chanx.RecvWithTimeout(c, 10*time.Millisecond)
+	fmt.Println("received:", ok)
+	// Output:
+	// received: false
+}
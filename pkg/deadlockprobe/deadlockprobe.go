@@ -0,0 +1,62 @@
+// Package deadlockprobe runs a target function in isolation and reports
+// whether it deadlocks, so demo code that is expected to block forever (like
+// the tutorial's unbuffered() and buffered() functions) can still be
+// exercised by go test instead of only being demonstrated by print.
+package deadlockprobe
+
+import (
+	"fmt"
+	"runtime"
+	"time"
+)
+
+// pollInterval is how often Run samples runtime.NumGoroutine while waiting
+// for fn to either finish or stop making progress.
+const pollInterval = 5 * time.Millisecond
+
+// DeadlockError reports that a probed function failed to make progress
+// before timeout elapsed, along with a stack trace captured at that point -
+// the same information the runtime prints as "fatal error: all goroutines
+// are asleep - deadlock!" right before it kills the process.
+type DeadlockError struct {
+	Timeout time.Duration
+	Stack   string
+}
+
+func (e *DeadlockError) Error() string {
+	return fmt.Sprintf("deadlockprobe: no progress after %s\n%s", e.Timeout, e.Stack)
+}
+
+// Run executes fn in its own goroutine and watches the goroutine count: fn
+// is considered deadlocked once timeout has elapsed and runtime.NumGoroutine
+// has stopped changing between polls, meaning nothing is still making
+// progress. Run returns nil if fn returns before that point, or a
+// *DeadlockError holding a captured stack trace otherwise.
+func Run(fn func(), timeout time.Duration) error {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn()
+	}()
+
+	deadline := time.Now().Add(timeout)
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	last := runtime.NumGoroutine()
+	for {
+		select {
+		case <-done:
+			return nil
+		case <-ticker.C:
+			n := runtime.NumGoroutine()
+			stable := n == last
+			last = n
+			if stable && time.Now().After(deadline) {
+				buf := make([]byte, 1<<16)
+				k := runtime.Stack(buf, true)
+				return &DeadlockError{Timeout: timeout, Stack: string(buf[:k])}
+			}
+		}
+	}
+}
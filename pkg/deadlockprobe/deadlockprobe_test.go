@@ -0,0 +1,39 @@
+package deadlockprobe_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Fvieira99/unbuferred-buffered-channels/pkg/deadlockprobe"
+	"github.com/Fvieira99/unbuferred-buffered-channels/pkg/demos"
+)
+
+// TestDemos machine-verifies the tutorial's claims about which
+// unbuffered/buffered channel functions deadlock and which don't.
+func TestDemos(t *testing.T) {
+	tests := []struct {
+		name      string
+		fn        func()
+		deadlocks bool
+	}{
+		{"Unbuffered", demos.Unbuffered, true},
+		{"UnbufferedCorrect", demos.UnbufferedCorrect, false},
+		{"Buffered", demos.Buffered, true},
+		{"BufferedCorrect", demos.BufferedCorrect, false},
+		{"BufferedClosingChannel", demos.BufferedClosingChannel, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := deadlockprobe.Run(tt.fn, 100*time.Millisecond)
+
+			var dlErr *deadlockprobe.DeadlockError
+			deadlocked := errors.As(err, &dlErr)
+			if deadlocked != tt.deadlocks {
+				t.Fatalf("Run(%s) deadlocked = %v, want %v (err: %v)", tt.name, deadlocked, tt.deadlocks, err)
+			}
+		})
+	}
+}
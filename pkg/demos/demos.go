@@ -0,0 +1,94 @@
+// Package demos holds the original unbuffered/buffered channel tutorial
+// functions, exported so pkg/deadlockprobe can run them in isolation and
+// verify which ones actually deadlock.
+package demos
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Unbuffered Channels -> There is no reserved space to allocate data, which means that a ready consumer is needed.
+// In other words, the channel is always full and the consumer must be ready before the data is pushed into the channel.
+
+// Unbuffered is the wrong approach example: the message will never be consumed.
+// As the program gets stuck on c <- 10 the consumer never gets ready to consume it.
+func Unbuffered() {
+	c := make(chan int)
+
+	c <- 10 // blocking here
+
+	msg := <-c
+	fmt.Println("Message from channel: ", msg)
+}
+
+// UnbufferedCorrect is the correct approach example.
+// Now the consumer will be ready before the channel receives a message, scheduling a go routine.
+// After the message is consumed the program will not be blocked anymore.
+func UnbufferedCorrect() {
+	c := make(chan int)
+
+	// Preparing go routine that will schedule a function responsible for consuming the message.
+	go func() {
+		msg := <-c
+		fmt.Println("Message from channel: ", msg)
+	}()
+
+	c <- 10 // blocking here
+	// It still blocks here until someone reads the message but it will no longer cause a deadlock error
+}
+
+// Buffered Channels -> It is possible to define the length of the channel so it can receive more than one message.
+// The channel does not need a ready consumer before the channel gets populated, to consume the message.
+// In other words buffered channels works like a queue of messages with a defined length.
+// If the buffered channel gets full it is going to cause a deadlock error.
+
+// Buffered is the wrong approach example: the messages are printed out, but it still causes a
+// deadlock error because all goroutines are asleep after printing the messages.
+// It happens because the range keeps waiting but the chan is never closed.
+func Buffered() {
+	c := make(chan int, 10)
+	c <- 10
+	c <- 20
+	for msg := range c {
+		fmt.Println("Message from channel: ", msg)
+	}
+}
+
+// BufferedCorrect adds a wait group so the result can be printed out correctly.
+// wg is needed because the code is only sending two messages to the channel and its size is 10,
+// so it will not block until the 10th message is sent.
+// Also, since sending is not blocking, it's not possible to guarantee that the consumer will read
+// the message before the program is over, since the consumer func is scheduled.
+func BufferedCorrect() {
+	c := make(chan int, 10)
+
+	wg := sync.WaitGroup{}
+
+	wg.Add(2)
+	go func(wg *sync.WaitGroup) {
+		for msg := range c {
+			fmt.Println("Message from channel: ", msg)
+			wg.Done()
+		}
+	}(&wg)
+
+	c <- 10
+	c <- 20
+	wg.Wait()
+	fmt.Println("Exiting Program: Work is done!")
+}
+
+// BufferedClosingChannel tries to do it without go routines by closing the channel, but close(c)
+// only runs after the range loop, and the range never ends while c is open, so it deadlocks the
+// same way Buffered does.
+func BufferedClosingChannel() {
+	c := make(chan int, 10)
+	c <- 10
+	c <- 20
+	for msg := range c {
+		fmt.Println("Message from channel: ", msg)
+	}
+
+	close(c)
+}
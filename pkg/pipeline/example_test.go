@@ -0,0 +1,73 @@
+package pipeline_test
+
+import (
+	"fmt"
+
+	"github.com/Fvieira99/unbuferred-buffered-channels/pkg/pipeline"
+)
+
+// ExampleStage shows a single processing stage: values flow through in
+// order and the output channel closes itself once the input is drained.
+func ExampleStage() {
+	in := make(chan int)
+	go func() {
+		defer close(in)
+		for i := 1; i <= 3; i++ {
+			in <- i
+		}
+	}()
+
+	out := pipeline.Stage(in, func(i int) int { return i * 10 })
+	for v := range out {
+		fmt.Println("Message from channel: ", v)
+	}
+	// Output:
+	// Message from channel:  10
+	// Message from channel:  20
+	// Message from channel:  30
+}
+
+// ExampleFanIn merges two producer channels into one and sums the result,
+// avoiding the order-sensitive assertions that concurrent producers would
+// otherwise force on the example.
+func ExampleFanIn() {
+	a := make(chan int, 1)
+	b := make(chan int, 1)
+	a <- 10
+	b <- 20
+	close(a)
+	close(b)
+
+	sum := 0
+	for v := range pipeline.FanIn(a, b) {
+		sum += v
+	}
+	fmt.Println("sum:", sum)
+	// Output:
+	// sum: 30
+}
+
+// ExampleFanOut splits a stream across two workers, runs each through its
+// own Stage, and merges the results back with FanIn.
+func ExampleFanOut() {
+	in := make(chan int)
+	go func() {
+		defer close(in)
+		in <- 10
+		in <- 20
+	}()
+
+	outs := pipeline.FanOut(in, 2)
+	stages := make([]<-chan int, len(outs))
+	for i, out := range outs {
+		stages[i] = pipeline.Stage(out, func(v int) int { return v })
+	}
+
+	sum := 0
+	for v := range pipeline.FanIn(stages...) {
+		sum += v
+	}
+	fmt.Println("sum:", sum)
+	// Output:
+	// sum: 30
+}
@@ -0,0 +1,42 @@
+package pipeline_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Fvieira99/unbuferred-buffered-channels/pkg/pipeline"
+)
+
+// TestFanOut_SlowConsumerDoesNotBlockOthers makes sure a slow consumer on
+// one output channel doesn't hold up delivery to a fast, ready consumer on
+// another, as FanOut's doc comment promises.
+func TestFanOut_SlowConsumerDoesNotBlockOthers(t *testing.T) {
+	in := make(chan int)
+	go func() {
+		defer close(in)
+		for i := 0; i < 6; i++ {
+			in <- i
+		}
+	}()
+
+	outs := pipeline.FanOut(in, 2)
+
+	slowDone := make(chan struct{})
+	go func() {
+		defer close(slowDone)
+		for range outs[1] {
+			time.Sleep(150 * time.Millisecond)
+		}
+	}()
+
+	start := time.Now()
+	for range outs[0] {
+	}
+	elapsed := time.Since(start)
+
+	if elapsed > 100*time.Millisecond {
+		t.Fatalf("fast consumer took %s to drain its channel; a slow sibling consumer should not block it", elapsed)
+	}
+
+	<-slowDone
+}
@@ -0,0 +1,76 @@
+// Package pipeline provides composable fan-out/fan-in channel stages
+// (FanOut, FanIn, Stage) that manage their own goroutines and channel
+// closing, so callers composing a pipeline don't have to hand-roll that
+// bookkeeping themselves.
+package pipeline
+
+import "sync"
+
+// FanOut distributes values read from in across n output channels so that
+// multiple workers can consume them concurrently. Each output channel has
+// its own goroutine competing to receive the next value from in, so a slow
+// consumer on one output only stalls its own channel rather than delaying
+// delivery to the others. Every output channel is closed exactly once, once
+// its goroutine observes in drained and closed.
+func FanOut[T any](in <-chan T, n int) []<-chan T {
+	outs := make([]chan T, n)
+	for i := range outs {
+		outs[i] = make(chan T)
+	}
+
+	for _, out := range outs {
+		go func(out chan T) {
+			defer close(out)
+			for v := range in {
+				out <- v
+			}
+		}(out)
+	}
+
+	result := make([]<-chan T, n)
+	for i, out := range outs {
+		result[i] = out
+	}
+	return result
+}
+
+// FanIn merges any number of input channels into a single output channel.
+// The output channel is closed exactly once, after every input channel has
+// been drained and closed.
+func FanIn[T any](chans ...<-chan T) <-chan T {
+	out := make(chan T)
+
+	var wg sync.WaitGroup
+	wg.Add(len(chans))
+	for _, c := range chans {
+		go func(c <-chan T) {
+			defer wg.Done()
+			for v := range c {
+				out <- v
+			}
+		}(c)
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	return out
+}
+
+// Stage applies worker to every value read from in and writes the results to
+// the returned channel, which is closed exactly once, after in is drained
+// and closed.
+func Stage[I, O any](in <-chan I, worker func(I) O) <-chan O {
+	out := make(chan O)
+
+	go func() {
+		defer close(out)
+		for v := range in {
+			out <- worker(v)
+		}
+	}()
+
+	return out
+}
@@ -0,0 +1,44 @@
+package workerpool_test
+
+import (
+	"fmt"
+
+	"github.com/Fvieira99/unbuferred-buffered-channels/pkg/workerpool"
+)
+
+// ExamplePool computes even/odd sums over 1..10 using several workers
+// feeding a single results channel, showing the "multiple producers, single
+// closer" idiom: Close plus an internal WaitGroup closes Results exactly
+// once instead of leaving the consumer's range stuck forever.
+func ExamplePool() {
+	type result struct {
+		n      int
+		isEven bool
+	}
+
+	pool := workerpool.New(4, 10, func(n int) result {
+		return result{n: n, isEven: n%2 == 0}
+	})
+
+	go func() {
+		for i := 1; i <= 10; i++ {
+			pool.Submit(i)
+		}
+		pool.Close()
+	}()
+
+	var evenSum, oddSum int
+	for r := range pool.Results() {
+		if r.isEven {
+			evenSum += r.n
+		} else {
+			oddSum += r.n
+		}
+	}
+
+	fmt.Println("even sum:", evenSum)
+	fmt.Println("odd sum:", oddSum)
+	// Output:
+	// even sum: 30
+	// odd sum: 25
+}
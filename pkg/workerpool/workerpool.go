@@ -0,0 +1,58 @@
+// Package workerpool provides a bounded worker pool: a fixed number of
+// goroutines draining a buffered input channel and publishing results on a
+// single output channel, which the pool closes automatically once Close is
+// called and every in-flight item has been processed.
+package workerpool
+
+import "sync"
+
+// Pool runs workers goroutines, each applying fn to items submitted via
+// Submit and publishing the result on the channel returned by Results.
+type Pool[I, O any] struct {
+	in  chan I
+	out chan O
+	wg  sync.WaitGroup
+}
+
+// New creates a Pool with the given number of workers and input buffer
+// size, and starts the workers immediately.
+func New[I, O any](workers int, buf int, fn func(I) O) *Pool[I, O] {
+	p := &Pool[I, O]{
+		in:  make(chan I, buf),
+		out: make(chan O),
+	}
+
+	p.wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer p.wg.Done()
+			for item := range p.in {
+				p.out <- fn(item)
+			}
+		}()
+	}
+
+	go func() {
+		p.wg.Wait()
+		close(p.out)
+	}()
+
+	return p
+}
+
+// Submit queues an item of work. It must not be called after Close.
+func (p *Pool[I, O]) Submit(item I) {
+	p.in <- item
+}
+
+// Close signals that no more items will be submitted. Workers keep draining
+// whatever is already queued; the Results channel closes once they're done.
+func (p *Pool[I, O]) Close() {
+	close(p.in)
+}
+
+// Results returns the channel workers publish to. It closes exactly once,
+// after Close is called and all in-flight work has drained.
+func (p *Pool[I, O]) Results() <-chan O {
+	return p.out
+}